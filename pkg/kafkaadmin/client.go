@@ -0,0 +1,217 @@
+// Package kafkaadmin is a thin wrapper around a Go Kafka client used to
+// create, describe, alter, and delete topics on a cluster using the same
+// broker and authentication configuration persisted on a
+// materialize_connection_kafka resource.
+package kafkaadmin
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+	"github.com/segmentio/kafka-go/sasl"
+	"github.com/segmentio/kafka-go/sasl/plain"
+	"github.com/segmentio/kafka-go/sasl/scram"
+)
+
+// Config carries the broker and authentication details needed to reach a
+// cluster. It is resolved from a materialize_connection_kafka resource by
+// the caller; SASLUsername/SASLPassword must be supplied separately since
+// Materialize does not expose a connection's secret material back out.
+type Config struct {
+	Brokers       []string
+	TLS           bool
+	SASLMechanism string
+	SASLUsername  string
+	SASLPassword  string
+	DialTimeoutMs int
+}
+
+// TopicConfig describes the desired state of a topic.
+type TopicConfig struct {
+	Name              string
+	Partitions        int
+	ReplicationFactor int
+	Config            map[string]string
+}
+
+// TopicInfo is the observed state of an existing topic.
+type TopicInfo struct {
+	Name       string
+	Partitions int
+	Config     map[string]string
+}
+
+// Client performs topic administration against a Kafka cluster.
+type Client struct {
+	client *kafka.Client
+	addr   net.Addr
+}
+
+// NewClient returns a Client that talks to the cluster's bootstrap brokers,
+// authenticating as configured.
+func NewClient(cfg Config) (*Client, error) {
+	if len(cfg.Brokers) == 0 {
+		return nil, fmt.Errorf("kafkaadmin: at least one broker is required")
+	}
+
+	transport := &kafka.Transport{DialTimeout: durationMs(cfg.DialTimeoutMs)}
+
+	if cfg.TLS {
+		transport.TLS = &tls.Config{}
+	}
+
+	if cfg.SASLMechanism != "" {
+		mechanism, err := saslMechanism(cfg)
+		if err != nil {
+			return nil, err
+		}
+		transport.SASL = mechanism
+	}
+
+	addr := kafka.TCP(cfg.Brokers...)
+
+	return &Client{
+		client: &kafka.Client{Addr: addr, Transport: transport},
+		addr:   addr,
+	}, nil
+}
+
+func saslMechanism(cfg Config) (sasl.Mechanism, error) {
+	switch cfg.SASLMechanism {
+	case "PLAIN":
+		return plain.Mechanism{Username: cfg.SASLUsername, Password: cfg.SASLPassword}, nil
+	case "SCRAM-SHA-256":
+		return scram.Mechanism(scram.SHA256, cfg.SASLUsername, cfg.SASLPassword)
+	case "SCRAM-SHA-512":
+		return scram.Mechanism(scram.SHA512, cfg.SASLUsername, cfg.SASLPassword)
+	default:
+		return nil, fmt.Errorf("kafkaadmin: unsupported SASL mechanism %q", cfg.SASLMechanism)
+	}
+}
+
+// CreateTopic creates a topic with the given partition count, replication
+// factor, and config overrides. If createIfNotExists is true, an existing
+// topic with the same name is treated as success.
+func (c *Client) CreateTopic(ctx context.Context, topic TopicConfig, createIfNotExists bool) error {
+	entries := make([]kafka.ConfigEntry, 0, len(topic.Config))
+	for k, v := range topic.Config {
+		entries = append(entries, kafka.ConfigEntry{ConfigName: k, ConfigValue: v})
+	}
+
+	resp, err := c.client.CreateTopics(ctx, &kafka.CreateTopicsRequest{
+		Addr: c.addr,
+		Topics: []kafka.TopicConfig{
+			{
+				Topic:             topic.Name,
+				NumPartitions:     topic.Partitions,
+				ReplicationFactor: topic.ReplicationFactor,
+				ConfigEntries:     entries,
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("kafkaadmin: create topic %q: %w", topic.Name, err)
+	}
+
+	if topicErr := resp.Errors[topic.Name]; topicErr != nil {
+		if createIfNotExists && topicErr == kafka.TopicAlreadyExists {
+			return nil
+		}
+		return fmt.Errorf("kafkaadmin: create topic %q: %w", topic.Name, topicErr)
+	}
+
+	return nil
+}
+
+// DescribeTopic returns the current partition count and config for a topic.
+func (c *Client) DescribeTopic(ctx context.Context, name string) (*TopicInfo, error) {
+	metadata, err := c.client.Metadata(ctx, &kafka.MetadataRequest{
+		Addr:   c.addr,
+		Topics: []string{name},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("kafkaadmin: describe topic %q: %w", name, err)
+	}
+	if len(metadata.Topics) == 0 {
+		return nil, fmt.Errorf("kafkaadmin: topic %q not found", name)
+	}
+	if err := metadata.Topics[0].Error; err != nil {
+		return nil, fmt.Errorf("kafkaadmin: describe topic %q: %w", name, err)
+	}
+
+	configResp, err := c.client.DescribeConfigs(ctx, &kafka.DescribeConfigsRequest{
+		Addr: c.addr,
+		Resources: []kafka.DescribeConfigRequestResource{
+			{ResourceType: kafka.ResourceTypeTopic, ResourceName: name},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("kafkaadmin: describe topic config %q: %w", name, err)
+	}
+
+	config := make(map[string]string)
+	for _, resource := range configResp.Resources {
+		for _, entry := range resource.ConfigEntries {
+			config[entry.ConfigName] = entry.ConfigValue
+		}
+	}
+
+	return &TopicInfo{
+		Name:       name,
+		Partitions: len(metadata.Topics[0].Partitions),
+		Config:     config,
+	}, nil
+}
+
+// AlterTopicConfig updates the dynamic config entries of an existing topic.
+func (c *Client) AlterTopicConfig(ctx context.Context, name string, config map[string]string) error {
+	entries := make([]kafka.AlterConfigRequestConfig, 0, len(config))
+	for k, v := range config {
+		entries = append(entries, kafka.AlterConfigRequestConfig{Name: k, Value: v})
+	}
+
+	resp, err := c.client.AlterConfigs(ctx, &kafka.AlterConfigsRequest{
+		Addr: c.addr,
+		Resources: []kafka.AlterConfigRequestResource{
+			{ResourceType: kafka.ResourceTypeTopic, ResourceName: name, Configs: entries},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("kafkaadmin: alter topic config %q: %w", name, err)
+	}
+
+	if resourceErr := resp.Errors[kafka.AlterConfigRequestResource{ResourceType: kafka.ResourceTypeTopic, ResourceName: name}]; resourceErr != nil {
+		return fmt.Errorf("kafkaadmin: alter topic config %q: %w", name, resourceErr)
+	}
+
+	return nil
+}
+
+// DeleteTopic deletes a topic. Callers can skip this for resources created
+// with delete_on_destroy = false.
+func (c *Client) DeleteTopic(ctx context.Context, name string) error {
+	resp, err := c.client.DeleteTopics(ctx, &kafka.DeleteTopicsRequest{
+		Addr:   c.addr,
+		Topics: []string{name},
+	})
+	if err != nil {
+		return fmt.Errorf("kafkaadmin: delete topic %q: %w", name, err)
+	}
+
+	if topicErr := resp.Errors[name]; topicErr != nil {
+		return fmt.Errorf("kafkaadmin: delete topic %q: %w", name, topicErr)
+	}
+
+	return nil
+}
+
+func durationMs(ms int) time.Duration {
+	if ms <= 0 {
+		return 10 * time.Second
+	}
+	return time.Duration(ms) * time.Millisecond
+}
@@ -0,0 +1,26 @@
+package kafkaadmin
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDurationMs(t *testing.T) {
+	cases := []struct {
+		name string
+		ms   int
+		want time.Duration
+	}{
+		{name: "positive", ms: 5000, want: 5 * time.Second},
+		{name: "zero defaults", ms: 0, want: 10 * time.Second},
+		{name: "negative defaults", ms: -1, want: 10 * time.Second},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := durationMs(c.ms); got != c.want {
+				t.Fatalf("durationMs(%d) = %v, want %v", c.ms, got, c.want)
+			}
+		})
+	}
+}
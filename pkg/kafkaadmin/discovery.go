@@ -0,0 +1,150 @@
+package kafkaadmin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"sort"
+	"strings"
+
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/kafka"
+)
+
+// confluentCloudClient is a minimal client for the Confluent Cloud cluster
+// describe API, just enough to resolve a cluster's bootstrap endpoint.
+type confluentCloudClient struct {
+	apiKey    string
+	apiSecret string
+}
+
+func (c confluentCloudClient) clusterBootstrapEndpoint(ctx context.Context, clusterId string) (string, error) {
+	url := fmt.Sprintf("https://api.confluent.cloud/cmk/v2/clusters/%s", clusterId)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return "", err
+	}
+	req.SetBasicAuth(c.apiKey, c.apiSecret)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unexpected status %s", resp.Status)
+	}
+
+	var out struct {
+		Spec struct {
+			KafkaBootstrapEndpoint string `json:"kafka_bootstrap_endpoint"`
+		} `json:"spec"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return "", err
+	}
+
+	return strings.TrimPrefix(out.Spec.KafkaBootstrapEndpoint, "SASL_SSL://"), nil
+}
+
+// ResolveMSKBootstrapBrokers looks up the current bootstrap broker string
+// for an MSK cluster and returns the individual brokers, so that Terraform
+// doesn't need a hardcoded, manually maintained broker list that goes stale
+// on cluster scale-out.
+func ResolveMSKBootstrapBrokers(ctx context.Context, clusterArn, authenticationType string) ([]string, error) {
+	switch authenticationType {
+	case "sasl_iam", "sasl_scram", "tls":
+	default:
+		return nil, fmt.Errorf("kafkaadmin: unsupported MSK authentication_type %q", authenticationType)
+	}
+
+	cfg, err := awsconfig.LoadDefaultConfig(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("kafkaadmin: loading AWS config: %w", err)
+	}
+
+	client := kafka.NewFromConfig(cfg)
+	out, err := client.GetBootstrapBrokers(ctx, &kafka.GetBootstrapBrokersInput{ClusterArn: &clusterArn})
+	if err != nil {
+		return nil, fmt.Errorf("kafkaadmin: get bootstrap brokers for %s: %w", clusterArn, err)
+	}
+
+	var brokers *string
+	switch authenticationType {
+	case "sasl_iam":
+		brokers = out.BootstrapBrokerStringSaslIam
+	case "sasl_scram":
+		brokers = out.BootstrapBrokerStringSaslScram
+	case "tls":
+		brokers = out.BootstrapBrokerStringTls
+	}
+
+	if brokers == nil || *brokers == "" {
+		return nil, fmt.Errorf("kafkaadmin: cluster %s has no bootstrap brokers for authentication_type %q", clusterArn, authenticationType)
+	}
+
+	return splitBrokerString(*brokers), nil
+}
+
+// ResolveDNSSRV resolves the brokers backing a DNS SRV record, e.g. one
+// published by a Kafka client's own service discovery.
+func ResolveDNSSRV(ctx context.Context, service string) ([]string, error) {
+	_, records, err := net.DefaultResolver.LookupSRV(ctx, "", "", service)
+	if err != nil {
+		return nil, fmt.Errorf("kafkaadmin: resolving SRV record %s: %w", service, err)
+	}
+
+	brokers := make([]string, len(records))
+	for i, r := range records {
+		brokers[i] = srvBrokerAddress(r.Target, r.Port)
+	}
+	sort.Strings(brokers)
+
+	return brokers, nil
+}
+
+// srvBrokerAddress formats an SRV record's target/port as a broker address.
+// net.LookupSRV returns Target as the FQDN the resolver reports, which
+// includes a trailing dot (e.g. "b-1.example.com."); strip it so the broker
+// address matches what's actually dialed.
+func srvBrokerAddress(target string, port uint16) string {
+	return fmt.Sprintf("%s:%d", strings.TrimSuffix(target, "."), port)
+}
+
+// ResolveConfluentCloudBrokers looks up the bootstrap brokers for a
+// Confluent Cloud cluster using a "key:secret" Confluent Cloud API key.
+func ResolveConfluentCloudBrokers(ctx context.Context, clusterId, apiKey string) ([]string, error) {
+	key, secret, ok := strings.Cut(apiKey, ":")
+	if !ok {
+		return nil, fmt.Errorf("kafkaadmin: api_key must be in the form \"key:secret\"")
+	}
+
+	client := confluentCloudClient{apiKey: key, apiSecret: secret}
+	bootstrap, err := client.clusterBootstrapEndpoint(ctx, clusterId)
+	if err != nil {
+		return nil, fmt.Errorf("kafkaadmin: resolving Confluent Cloud cluster %s: %w", clusterId, err)
+	}
+
+	return splitBrokerString(bootstrap), nil
+}
+
+// splitBrokerString splits a broker endpoint list into its individual
+// entries and sorts them, so that AWS/Confluent returning the same brokers
+// in a different order doesn't surface as drift on resolved_brokers.
+func splitBrokerString(brokers string) []string {
+	var out []string
+	start := 0
+	for i := 0; i <= len(brokers); i++ {
+		if i == len(brokers) || brokers[i] == ',' {
+			if i > start {
+				out = append(out, brokers[start:i])
+			}
+			start = i + 1
+		}
+	}
+	sort.Strings(out)
+	return out
+}
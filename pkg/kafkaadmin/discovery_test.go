@@ -0,0 +1,64 @@
+package kafkaadmin
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestSplitBrokerString(t *testing.T) {
+	cases := []struct {
+		name string
+		in   string
+		want []string
+	}{
+		{name: "single", in: "b-1.example.com:9092", want: []string{"b-1.example.com:9092"}},
+		{name: "multiple", in: "b-1.example.com:9092,b-2.example.com:9092", want: []string{"b-1.example.com:9092", "b-2.example.com:9092"}},
+		{name: "empty", in: "", want: nil},
+		{name: "trailing comma", in: "b-1.example.com:9092,", want: []string{"b-1.example.com:9092"}},
+		{name: "out of order", in: "b-2.example.com:9092,b-1.example.com:9092", want: []string{"b-1.example.com:9092", "b-2.example.com:9092"}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := splitBrokerString(c.in)
+			if !reflect.DeepEqual(got, c.want) {
+				t.Fatalf("splitBrokerString(%q) = %#v, want %#v", c.in, got, c.want)
+			}
+		})
+	}
+}
+
+func TestSrvBrokerAddress(t *testing.T) {
+	cases := []struct {
+		name   string
+		target string
+		port   uint16
+		want   string
+	}{
+		{name: "trailing dot stripped", target: "b-1.example.com.", port: 9092, want: "b-1.example.com:9092"},
+		{name: "no trailing dot", target: "b-1.example.com", port: 9092, want: "b-1.example.com:9092"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := srvBrokerAddress(c.target, c.port); got != c.want {
+				t.Fatalf("srvBrokerAddress(%q, %d) = %q, want %q", c.target, c.port, got, c.want)
+			}
+		})
+	}
+}
+
+func TestResolveMSKBootstrapBrokers_UnsupportedAuthenticationType(t *testing.T) {
+	_, err := ResolveMSKBootstrapBrokers(context.Background(), "arn:aws:kafka:us-east-1:123456789012:cluster/example/abc", "sasl_plain")
+	if err == nil {
+		t.Fatal("expected an error for an unsupported authentication_type, got nil")
+	}
+}
+
+func TestResolveConfluentCloudBrokers_InvalidApiKey(t *testing.T) {
+	_, err := ResolveConfluentCloudBrokers(context.Background(), "lkc-abc123", "not-a-key-secret-pair")
+	if err == nil {
+		t.Fatal("expected an error for an api_key without a \"key:secret\" separator, got nil")
+	}
+}
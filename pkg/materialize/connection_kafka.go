@@ -2,6 +2,7 @@ package materialize
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 )
 
@@ -10,19 +11,43 @@ type KafkaBroker struct {
 	TargetGroupPort       int
 	AvailabilityZone      string
 	PrivateLinkConnection IdentifierSchemaStruct
+	SSHTunnel             IdentifierSchemaStruct
+}
+
+// KafkaAwsIam holds the inline AWS credentials used to authenticate to an
+// MSK cluster via the `AWS-IAM` SASL mechanism.
+type KafkaAwsIam struct {
+	Region          string
+	AccessKeyId     ValueSecretStruct
+	SecretAccessKey IdentifierSchemaStruct
+	SessionToken    IdentifierSchemaStruct
+	RoleArn         string
+	ExternalId      string
+}
+
+// KafkaOAuthBearer holds the client-credentials configuration used to
+// acquire bearer tokens at connect time via the `OAUTHBEARER` SASL mechanism.
+type KafkaOAuthBearer struct {
+	TokenEndpointUrl string
+	ClientId         string
+	ClientSecret     IdentifierSchemaStruct
+	Scope            string
+	Extensions       map[string]string
 }
 
 type ConnectionKafkaBuilder struct {
 	Connection
-	kafkaBrokers        []KafkaBroker
-	kafkaProgressTopic  string
-	kafkaSSLCa          ValueSecretStruct
-	kafkaSSLCert        ValueSecretStruct
-	kafkaSSLKey         IdentifierSchemaStruct
-	kafkaSASLMechanisms string
-	kafkaSASLUsername   ValueSecretStruct
-	kafkaSASLPassword   IdentifierSchemaStruct
-	kafkaSSHTunnel      IdentifierSchemaStruct
+	kafkaBrokers         []KafkaBroker
+	kafkaProgressTopic   string
+	kafkaSSLCa           ValueSecretStruct
+	kafkaSSLCert         ValueSecretStruct
+	kafkaSSLKey          IdentifierSchemaStruct
+	kafkaSASLMechanisms  string
+	kafkaSASLUsername    ValueSecretStruct
+	kafkaSASLPassword    IdentifierSchemaStruct
+	kafkaSASLAwsIam      KafkaAwsIam
+	kafkaSASLOAuthBearer KafkaOAuthBearer
+	kafkaSSHTunnel       IdentifierSchemaStruct
 }
 
 func NewConnectionKafkaBuilder(connectionName, schemaName, databaseName string) *ConnectionKafkaBuilder {
@@ -71,6 +96,16 @@ func (b *ConnectionKafkaBuilder) KafkaSASLPassword(kafkaSASLPassword IdentifierS
 	return b
 }
 
+func (b *ConnectionKafkaBuilder) KafkaSASLAwsIam(kafkaSASLAwsIam KafkaAwsIam) *ConnectionKafkaBuilder {
+	b.kafkaSASLAwsIam = kafkaSASLAwsIam
+	return b
+}
+
+func (b *ConnectionKafkaBuilder) KafkaSASLOAuthBearer(kafkaSASLOAuthBearer KafkaOAuthBearer) *ConnectionKafkaBuilder {
+	b.kafkaSASLOAuthBearer = kafkaSASLOAuthBearer
+	return b
+}
+
 func (b *ConnectionKafkaBuilder) KafkaSSHTunnel(kafkaSSHTunnel IdentifierSchemaStruct) *ConnectionKafkaBuilder {
 	b.kafkaSSHTunnel = kafkaSSHTunnel
 	return b
@@ -80,33 +115,28 @@ func (b *ConnectionKafkaBuilder) Create() string {
 	q := strings.Builder{}
 	q.WriteString(fmt.Sprintf(`CREATE CONNECTION %s TO KAFKA (`, b.QualifiedName()))
 
-	if b.kafkaSSHTunnel.Name != "" {
-		q.WriteString(`BROKERS (`)
-		for i, broker := range b.kafkaBrokers {
-			q.WriteString(fmt.Sprintf(`%s USING SSH TUNNEL %s`, QuoteString(broker.Broker), QualifiedName(b.kafkaSSHTunnel.DatabaseName, b.kafkaSSHTunnel.SchemaName, b.kafkaSSHTunnel.Name)))
-			if i < len(b.kafkaBrokers)-1 {
-				q.WriteString(`,`)
-			}
+	q.WriteString(`BROKERS (`)
+	for i, broker := range b.kafkaBrokers {
+		sshTunnel := broker.SSHTunnel
+		if sshTunnel.Name == "" {
+			sshTunnel = b.kafkaSSHTunnel
 		}
-		q.WriteString(`)`)
-	} else {
-		q.WriteString(`BROKERS (`)
-		for i, broker := range b.kafkaBrokers {
-			if broker.TargetGroupPort != 0 && broker.AvailabilityZone != "" && broker.PrivateLinkConnection.Name != "" {
-				q.WriteString(fmt.Sprintf(`%s USING AWS PRIVATELINK %s (PORT %d, AVAILABILITY ZONE %s)`, QuoteString(broker.Broker),
-					QualifiedName(broker.PrivateLinkConnection.DatabaseName, broker.PrivateLinkConnection.SchemaName, broker.PrivateLinkConnection.Name), broker.TargetGroupPort, QuoteString(broker.AvailabilityZone)))
-				if i < len(b.kafkaBrokers)-1 {
-					q.WriteString(`, `)
-				}
-			} else {
-				q.WriteString(QuoteString(broker.Broker))
-				if i < len(b.kafkaBrokers)-1 {
-					q.WriteString(`, `)
-				}
-			}
+
+		switch {
+		case broker.TargetGroupPort != 0 && broker.AvailabilityZone != "" && broker.PrivateLinkConnection.Name != "":
+			q.WriteString(fmt.Sprintf(`%s USING AWS PRIVATELINK %s (PORT %d, AVAILABILITY ZONE %s)`, QuoteString(broker.Broker),
+				QualifiedName(broker.PrivateLinkConnection.DatabaseName, broker.PrivateLinkConnection.SchemaName, broker.PrivateLinkConnection.Name), broker.TargetGroupPort, QuoteString(broker.AvailabilityZone)))
+		case sshTunnel.Name != "":
+			q.WriteString(fmt.Sprintf(`%s USING SSH TUNNEL %s`, QuoteString(broker.Broker), QualifiedName(sshTunnel.DatabaseName, sshTunnel.SchemaName, sshTunnel.Name)))
+		default:
+			q.WriteString(QuoteString(broker.Broker))
+		}
+
+		if i < len(b.kafkaBrokers)-1 {
+			q.WriteString(`, `)
 		}
-		q.WriteString(`)`)
 	}
+	q.WriteString(`)`)
 
 	if b.kafkaProgressTopic != "" {
 		q.WriteString(fmt.Sprintf(`, PROGRESS TOPIC %s`, QuoteString(b.kafkaProgressTopic)))
@@ -138,6 +168,52 @@ func (b *ConnectionKafkaBuilder) Create() string {
 	if b.kafkaSASLPassword.Name != "" {
 		q.WriteString(fmt.Sprintf(`, SASL PASSWORD = SECRET %s`, b.kafkaSASLPassword.QualifiedName()))
 	}
+	if b.kafkaSASLAwsIam.Region != "" {
+		q.WriteString(`, AWS (`)
+		q.WriteString(fmt.Sprintf(`REGION = %s`, QuoteString(b.kafkaSASLAwsIam.Region)))
+		if b.kafkaSASLAwsIam.AccessKeyId.Text != "" {
+			q.WriteString(fmt.Sprintf(`, ACCESS KEY ID = %s`, QuoteString(b.kafkaSASLAwsIam.AccessKeyId.Text)))
+		}
+		if b.kafkaSASLAwsIam.AccessKeyId.Secret.Name != "" {
+			q.WriteString(fmt.Sprintf(`, ACCESS KEY ID = SECRET %s`, b.kafkaSASLAwsIam.AccessKeyId.Secret.QualifiedName()))
+		}
+		if b.kafkaSASLAwsIam.SecretAccessKey.Name != "" {
+			q.WriteString(fmt.Sprintf(`, SECRET ACCESS KEY = SECRET %s`, b.kafkaSASLAwsIam.SecretAccessKey.QualifiedName()))
+		}
+		if b.kafkaSASLAwsIam.SessionToken.Name != "" {
+			q.WriteString(fmt.Sprintf(`, SESSION TOKEN = SECRET %s`, b.kafkaSASLAwsIam.SessionToken.QualifiedName()))
+		}
+		if b.kafkaSASLAwsIam.RoleArn != "" {
+			q.WriteString(fmt.Sprintf(`, ASSUME ROLE ARN = %s`, QuoteString(b.kafkaSASLAwsIam.RoleArn)))
+		}
+		if b.kafkaSASLAwsIam.ExternalId != "" {
+			q.WriteString(fmt.Sprintf(`, ASSUME ROLE EXTERNAL ID = %s`, QuoteString(b.kafkaSASLAwsIam.ExternalId)))
+		}
+		q.WriteString(`)`)
+	}
+	if b.kafkaSASLOAuthBearer.TokenEndpointUrl != "" {
+		q.WriteString(fmt.Sprintf(`, OAUTHBEARER (TOKEN ENDPOINT URL = %s`, QuoteString(b.kafkaSASLOAuthBearer.TokenEndpointUrl)))
+		if b.kafkaSASLOAuthBearer.ClientId != "" {
+			q.WriteString(fmt.Sprintf(`, CLIENT ID = %s`, QuoteString(b.kafkaSASLOAuthBearer.ClientId)))
+		}
+		if b.kafkaSASLOAuthBearer.ClientSecret.Name != "" {
+			q.WriteString(fmt.Sprintf(`, CLIENT SECRET = SECRET %s`, b.kafkaSASLOAuthBearer.ClientSecret.QualifiedName()))
+		}
+		if b.kafkaSASLOAuthBearer.Scope != "" {
+			q.WriteString(fmt.Sprintf(`, SCOPE = %s`, QuoteString(b.kafkaSASLOAuthBearer.Scope)))
+		}
+		if len(b.kafkaSASLOAuthBearer.Extensions) > 0 {
+			keys := make([]string, 0, len(b.kafkaSASLOAuthBearer.Extensions))
+			for k := range b.kafkaSASLOAuthBearer.Extensions {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+			for _, k := range keys {
+				q.WriteString(fmt.Sprintf(`, OAUTHBEARER EXTENSION %s = %s`, QuoteString(k), QuoteString(b.kafkaSASLOAuthBearer.Extensions[k])))
+			}
+		}
+		q.WriteString(`)`)
+	}
 
 	q.WriteString(`);`)
 	return q.String()
@@ -155,3 +231,85 @@ func (b *ConnectionKafkaBuilder) Drop() string {
 func (b *ConnectionKafkaBuilder) ReadId() string {
 	return ReadConnectionId(b.ConnectionName, b.SchemaName, b.DatabaseName)
 }
+
+func GetKafkaAwsIamStruct(v interface{}) KafkaAwsIam {
+	var awsIam KafkaAwsIam
+	u := v.([]interface{})[0].(map[string]interface{})
+
+	if v, ok := u["region"]; ok {
+		awsIam.Region = v.(string)
+	}
+	if v, ok := u["access_key_id"]; ok {
+		awsIam.AccessKeyId = GetValueSecretStruct(v)
+	}
+	if v, ok := u["secret_access_key"]; ok {
+		awsIam.SecretAccessKey = GetIdentifierSchemaStruct(v)
+	}
+	if v, ok := u["session_token"]; ok {
+		awsIam.SessionToken = GetIdentifierSchemaStruct(v)
+	}
+	if v, ok := u["role_arn"]; ok {
+		awsIam.RoleArn = v.(string)
+	}
+	if v, ok := u["external_id"]; ok {
+		awsIam.ExternalId = v.(string)
+	}
+
+	return awsIam
+}
+
+func GetKafkaOAuthBearerStruct(v interface{}) KafkaOAuthBearer {
+	var oauthBearer KafkaOAuthBearer
+	u := v.([]interface{})[0].(map[string]interface{})
+
+	if v, ok := u["token_endpoint_url"]; ok {
+		oauthBearer.TokenEndpointUrl = v.(string)
+	}
+	if v, ok := u["client_id"]; ok {
+		oauthBearer.ClientId = v.(string)
+	}
+	if v, ok := u["client_secret"]; ok {
+		oauthBearer.ClientSecret = GetIdentifierSchemaStruct(v)
+	}
+	if v, ok := u["scope"]; ok {
+		oauthBearer.Scope = v.(string)
+	}
+	if v, ok := u["extensions"]; ok {
+		extensions := make(map[string]string)
+		for key, value := range v.(map[string]interface{}) {
+			extensions[key] = value.(string)
+		}
+		oauthBearer.Extensions = extensions
+	}
+
+	return oauthBearer
+}
+
+func GetKafkaBrokersStruct(v interface{}) []KafkaBroker {
+	var brokers []KafkaBroker
+
+	for _, broker := range v.([]interface{}) {
+		b := broker.(map[string]interface{})
+
+		var broker KafkaBroker
+		if v, ok := b["broker"]; ok {
+			broker.Broker = v.(string)
+		}
+		if v, ok := b["target_group_port"]; ok {
+			broker.TargetGroupPort = v.(int)
+		}
+		if v, ok := b["availability_zone"]; ok {
+			broker.AvailabilityZone = v.(string)
+		}
+		if v, ok := b["privatelink_connection"]; ok {
+			broker.PrivateLinkConnection = GetIdentifierSchemaStruct(v)
+		}
+		if v, ok := b["ssh_tunnel"]; ok {
+			broker.SSHTunnel = GetIdentifierSchemaStruct(v)
+		}
+
+		brokers = append(brokers, broker)
+	}
+
+	return brokers
+}
@@ -0,0 +1,97 @@
+package materialize
+
+import "testing"
+
+func TestConnectionKafkaBuilder_Create_MixedBrokerRouting(t *testing.T) {
+	b := NewConnectionKafkaBuilder("kafka_connection", "public", "materialize")
+	b.KafkaSSHTunnel(IdentifierSchemaStruct{Name: "default_tunnel", SchemaName: "public", DatabaseName: "materialize"})
+	b.KafkaBrokers([]KafkaBroker{
+		{
+			Broker:                "b-1.msk.example.com:9096",
+			TargetGroupPort:       9001,
+			AvailabilityZone:      "use1-az1",
+			PrivateLinkConnection: IdentifierSchemaStruct{Name: "privatelink_conn", SchemaName: "public", DatabaseName: "materialize"},
+		},
+		{
+			Broker:    "b-2.msk.example.com:9096",
+			SSHTunnel: IdentifierSchemaStruct{Name: "broker_2_tunnel", SchemaName: "public", DatabaseName: "materialize"},
+		},
+		{
+			Broker: "b-3.msk.example.com:9096",
+		},
+	})
+
+	r := b.Create()
+	want := `CREATE CONNECTION "materialize"."public"."kafka_connection" TO KAFKA (BROKERS ('b-1.msk.example.com:9096' USING AWS PRIVATELINK "materialize"."public"."privatelink_conn" (PORT 9001, AVAILABILITY ZONE 'use1-az1'), 'b-2.msk.example.com:9096' USING SSH TUNNEL "materialize"."public"."broker_2_tunnel", 'b-3.msk.example.com:9096' USING SSH TUNNEL "materialize"."public"."default_tunnel"));`
+
+	if r != want {
+		t.Fatalf("Create() = %q, want %q", r, want)
+	}
+}
+
+func TestConnectionKafkaBuilder_Create_MixedBrokerRouting_NoDefaultTunnel(t *testing.T) {
+	b := NewConnectionKafkaBuilder("kafka_connection", "public", "materialize")
+	b.KafkaBrokers([]KafkaBroker{
+		{
+			Broker:                "b-1.msk.example.com:9096",
+			TargetGroupPort:       9001,
+			AvailabilityZone:      "use1-az1",
+			PrivateLinkConnection: IdentifierSchemaStruct{Name: "privatelink_conn", SchemaName: "public", DatabaseName: "materialize"},
+		},
+		{
+			Broker:    "b-2.msk.example.com:9096",
+			SSHTunnel: IdentifierSchemaStruct{Name: "broker_2_tunnel", SchemaName: "public", DatabaseName: "materialize"},
+		},
+		{
+			Broker: "b-3.msk.example.com:9096",
+		},
+	})
+
+	r := b.Create()
+	want := `CREATE CONNECTION "materialize"."public"."kafka_connection" TO KAFKA (BROKERS ('b-1.msk.example.com:9096' USING AWS PRIVATELINK "materialize"."public"."privatelink_conn" (PORT 9001, AVAILABILITY ZONE 'use1-az1'), 'b-2.msk.example.com:9096' USING SSH TUNNEL "materialize"."public"."broker_2_tunnel", 'b-3.msk.example.com:9096'));`
+
+	if r != want {
+		t.Fatalf("Create() = %q, want %q", r, want)
+	}
+}
+
+func TestConnectionKafkaBuilder_Create_OAuthBearer(t *testing.T) {
+	b := NewConnectionKafkaBuilder("kafka_connection", "public", "materialize")
+	b.KafkaBrokers([]KafkaBroker{{Broker: "b-1.kafka.example.com:9092"}})
+	b.KafkaSASLMechanisms("OAUTHBEARER")
+	b.KafkaSASLOAuthBearer(KafkaOAuthBearer{
+		TokenEndpointUrl: "https://idp.example.com/oauth/token",
+		ClientId:         "materialize",
+		ClientSecret:     IdentifierSchemaStruct{Name: "oauth_client_secret", SchemaName: "public", DatabaseName: "materialize"},
+		Scope:            "kafka",
+		Extensions:       map[string]string{"logicalCluster": "lkc-abc123", "identityPoolId": "pool-1"},
+	})
+
+	r := b.Create()
+	want := `CREATE CONNECTION "materialize"."public"."kafka_connection" TO KAFKA (BROKERS ('b-1.kafka.example.com:9092'), SASL MECHANISMS = 'OAUTHBEARER', OAUTHBEARER (TOKEN ENDPOINT URL = 'https://idp.example.com/oauth/token', CLIENT ID = 'materialize', CLIENT SECRET = SECRET "materialize"."public"."oauth_client_secret", SCOPE = 'kafka', OAUTHBEARER EXTENSION 'identityPoolId' = 'pool-1', OAUTHBEARER EXTENSION 'logicalCluster' = 'lkc-abc123'));`
+
+	if r != want {
+		t.Fatalf("Create() = %q, want %q", r, want)
+	}
+}
+
+func TestConnectionKafkaBuilder_Create_AwsIam(t *testing.T) {
+	b := NewConnectionKafkaBuilder("kafka_connection", "public", "materialize")
+	b.KafkaBrokers([]KafkaBroker{{Broker: "b-1.msk.example.com:9096"}})
+	b.KafkaSASLMechanisms("AWS-IAM")
+	b.KafkaSASLAwsIam(KafkaAwsIam{
+		Region:          "us-east-1",
+		AccessKeyId:     ValueSecretStruct{Text: "AKIAEXAMPLE"},
+		SecretAccessKey: IdentifierSchemaStruct{Name: "msk_secret_key", SchemaName: "public", DatabaseName: "materialize"},
+		SessionToken:    IdentifierSchemaStruct{Name: "msk_session_token", SchemaName: "public", DatabaseName: "materialize"},
+		RoleArn:         "arn:aws:iam::123456789012:role/msk-access",
+		ExternalId:      "ext-id",
+	})
+
+	r := b.Create()
+	want := `CREATE CONNECTION "materialize"."public"."kafka_connection" TO KAFKA (BROKERS ('b-1.msk.example.com:9096'), SASL MECHANISMS = 'AWS-IAM', AWS (REGION = 'us-east-1', ACCESS KEY ID = 'AKIAEXAMPLE', SECRET ACCESS KEY = SECRET "materialize"."public"."msk_secret_key", SESSION TOKEN = SECRET "materialize"."public"."msk_session_token", ASSUME ROLE ARN = 'arn:aws:iam::123456789012:role/msk-access', ASSUME ROLE EXTERNAL ID = 'ext-id'));`
+
+	if r != want {
+		t.Fatalf("Create() = %q, want %q", r, want)
+	}
+}
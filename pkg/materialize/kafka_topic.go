@@ -0,0 +1,43 @@
+package materialize
+
+import (
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// KafkaConnectionConfig is the non-secret broker and authentication
+// configuration persisted on a materialize_connection_kafka resource,
+// resolved so that it can be reused by tools (such as the Kafka topic admin
+// client) that talk to the cluster directly instead of through Materialize
+// SQL. Materialize does not expose a connection's secret material (e.g. a
+// SASL password) back out through any system catalog, so credentials that
+// require a secret must be supplied directly to the caller instead.
+type KafkaConnectionConfig struct {
+	Brokers       []string
+	TLS           bool
+	SASLMechanism string
+}
+
+// ReadKafkaConnectionConfig looks up the broker list and SASL/TLS settings
+// for an existing Kafka connection so callers can reuse them without asking
+// the user to duplicate connection details on the topic resource.
+func ReadKafkaConnectionConfig(metaDb *sqlx.DB, connectionName, schemaName, databaseName string) (KafkaConnectionConfig, error) {
+	var config KafkaConnectionConfig
+
+	q := fmt.Sprintf(
+		`SELECT brokers, ssl, sasl_mechanism
+		 FROM mz_internal.mz_kafka_connections
+		 WHERE name = %s AND schema_name = %s AND database_name = %s;`,
+		QuoteString(connectionName), QuoteString(schemaName), QuoteString(databaseName),
+	)
+
+	// brokers is a Postgres text[] column; database/sql can't scan that
+	// directly into a []string, so it needs pq.Array's Scanner wrapper.
+	if err := metaDb.QueryRowx(q).Scan(pq.Array(&config.Brokers), &config.TLS, &config.SASLMechanism); err != nil {
+		return config, fmt.Errorf("reading kafka connection %s.%s.%s: %w", databaseName, schemaName, connectionName, err)
+	}
+
+	return config, nil
+}
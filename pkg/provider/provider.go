@@ -0,0 +1,20 @@
+package provider
+
+import (
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/resources"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Provider returns the schema.Provider for materialize, wiring up every
+// resource this package exposes.
+func Provider() *schema.Provider {
+	return &schema.Provider{
+		ResourcesMap: map[string]*schema.Resource{
+			"materialize_connection_kafka":     resources.ConnectionKafka(),
+			"materialize_grant_secret":         resources.GrantSecret(),
+			"materialize_kafka_topic":          resources.KafkaTopic(),
+			"materialize_aws_msk_scram_secret": resources.AwsMskScramSecret(),
+		},
+	}
+}
@@ -0,0 +1,216 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/kafka"
+	kafkatypes "github.com/aws/aws-sdk-go-v2/service/kafka/types"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var awsMskScramSecretSchema = map[string]*schema.Schema{
+	"cluster_arn": {
+		Description: "The ARN of the MSK cluster to associate the secrets with.",
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+	},
+	"secret_arn_list": {
+		Description: "The ARNs of the AWS Secrets Manager secrets, each containing SCRAM credentials, to associate with the cluster.",
+		Type:        schema.TypeList,
+		Required:    true,
+		MinItems:    1,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	},
+}
+
+func AwsMskScramSecret() *schema.Resource {
+	return &schema.Resource{
+		Description: "Associates AWS Secrets Manager secrets containing SCRAM credentials with an MSK cluster, analogous to the `aws_msk_scram_secret` resource in the AWS provider.",
+
+		CreateContext: awsMskScramSecretCreate,
+		ReadContext:   awsMskScramSecretRead,
+		UpdateContext: awsMskScramSecretUpdate,
+		DeleteContext: awsMskScramSecretDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: awsMskScramSecretSchema,
+	}
+}
+
+func newMskClient(ctx context.Context) (*kafka.Client, error) {
+	cfg, err := awsconfig.LoadDefaultConfig(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("loading AWS config: %w", err)
+	}
+	return kafka.NewFromConfig(cfg), nil
+}
+
+func secretArnList(d *schema.ResourceData) []string {
+	return stringListFromInterfaces(d.Get("secret_arn_list").([]interface{}))
+}
+
+func stringListFromInterfaces(raw []interface{}) []string {
+	list := make([]string, len(raw))
+	for i, v := range raw {
+		list[i] = v.(string)
+	}
+	return list
+}
+
+// diffScramSecretArns compares the previous and desired secret_arn_list and
+// returns the ARNs that need to be associated (added) or disassociated
+// (removed) to reconcile the cluster with the desired list.
+func diffScramSecretArns(old, new []string) (added, removed []string) {
+	oldSet := make(map[string]bool, len(old))
+	for _, arn := range old {
+		oldSet[arn] = true
+	}
+
+	for _, arn := range new {
+		if oldSet[arn] {
+			delete(oldSet, arn)
+		} else {
+			added = append(added, arn)
+		}
+	}
+	for arn := range oldSet {
+		removed = append(removed, arn)
+	}
+
+	return added, removed
+}
+
+// unprocessedScramSecretsError reports the per-ARN failures MSK returns in
+// UnprocessedScramSecrets. The batch associate/disassociate calls return a
+// nil error even when some (or all) ARNs were rejected, so the caller must
+// check this list explicitly rather than relying on err alone.
+func unprocessedScramSecretsError(unprocessed []kafkatypes.UnprocessedScramSecret) error {
+	if len(unprocessed) == 0 {
+		return nil
+	}
+
+	messages := make([]string, len(unprocessed))
+	for i, u := range unprocessed {
+		messages[i] = fmt.Sprintf("%s: %s (%s)", aws.ToString(u.SecretArn), aws.ToString(u.ErrorMessage), aws.ToString(u.ErrorCode))
+	}
+
+	return fmt.Errorf("failed to process SCRAM secret(s): %s", strings.Join(messages, "; "))
+}
+
+func awsMskScramSecretCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := newMskClient(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	clusterArn := d.Get("cluster_arn").(string)
+
+	out, err := client.BatchAssociateScramSecret(ctx, &kafka.BatchAssociateScramSecretInput{
+		ClusterArn:    &clusterArn,
+		SecretArnList: secretArnList(d),
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("associating SCRAM secrets with cluster %s: %w", clusterArn, err))
+	}
+	if err := unprocessedScramSecretsError(out.UnprocessedScramSecrets); err != nil {
+		return diag.FromErr(fmt.Errorf("associating SCRAM secrets with cluster %s: %w", clusterArn, err))
+	}
+
+	d.SetId(clusterArn)
+
+	return awsMskScramSecretRead(ctx, d, meta)
+}
+
+func awsMskScramSecretRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := newMskClient(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	clusterArn := d.Id()
+
+	out, err := client.ListScramSecrets(ctx, &kafka.ListScramSecretsInput{ClusterArn: &clusterArn})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("listing SCRAM secrets for cluster %s: %w", clusterArn, err))
+	}
+
+	if err := d.Set("cluster_arn", clusterArn); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("secret_arn_list", out.SecretArnList); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func awsMskScramSecretUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := newMskClient(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	clusterArn := d.Id()
+	oldRaw, newRaw := d.GetChange("secret_arn_list")
+	added, removed := diffScramSecretArns(stringListFromInterfaces(oldRaw.([]interface{})), stringListFromInterfaces(newRaw.([]interface{})))
+
+	if len(added) > 0 {
+		out, err := client.BatchAssociateScramSecret(ctx, &kafka.BatchAssociateScramSecretInput{
+			ClusterArn:    &clusterArn,
+			SecretArnList: added,
+		})
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("associating SCRAM secrets with cluster %s: %w", clusterArn, err))
+		}
+		if err := unprocessedScramSecretsError(out.UnprocessedScramSecrets); err != nil {
+			return diag.FromErr(fmt.Errorf("associating SCRAM secrets with cluster %s: %w", clusterArn, err))
+		}
+	}
+
+	if len(removed) > 0 {
+		out, err := client.BatchDisassociateScramSecret(ctx, &kafka.BatchDisassociateScramSecretInput{
+			ClusterArn:    &clusterArn,
+			SecretArnList: removed,
+		})
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("disassociating SCRAM secrets from cluster %s: %w", clusterArn, err))
+		}
+		if err := unprocessedScramSecretsError(out.UnprocessedScramSecrets); err != nil {
+			return diag.FromErr(fmt.Errorf("disassociating SCRAM secrets from cluster %s: %w", clusterArn, err))
+		}
+	}
+
+	return awsMskScramSecretRead(ctx, d, meta)
+}
+
+func awsMskScramSecretDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := newMskClient(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	clusterArn := d.Id()
+
+	out, err := client.BatchDisassociateScramSecret(ctx, &kafka.BatchDisassociateScramSecretInput{
+		ClusterArn:    &clusterArn,
+		SecretArnList: secretArnList(d),
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("disassociating SCRAM secrets from cluster %s: %w", clusterArn, err))
+	}
+	if err := unprocessedScramSecretsError(out.UnprocessedScramSecrets); err != nil {
+		return diag.FromErr(fmt.Errorf("disassociating SCRAM secrets from cluster %s: %w", clusterArn, err))
+	}
+
+	return nil
+}
@@ -0,0 +1,105 @@
+package resources
+
+import (
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	kafkatypes "github.com/aws/aws-sdk-go-v2/service/kafka/types"
+)
+
+func TestDiffScramSecretArns(t *testing.T) {
+	cases := []struct {
+		name        string
+		old, new    []string
+		wantAdded   []string
+		wantRemoved []string
+	}{
+		{
+			name:        "no change",
+			old:         []string{"arn:aws:secretsmanager:us-east-1:1:secret:a"},
+			new:         []string{"arn:aws:secretsmanager:us-east-1:1:secret:a"},
+			wantAdded:   nil,
+			wantRemoved: nil,
+		},
+		{
+			name:        "add only",
+			old:         []string{"arn:aws:secretsmanager:us-east-1:1:secret:a"},
+			new:         []string{"arn:aws:secretsmanager:us-east-1:1:secret:a", "arn:aws:secretsmanager:us-east-1:1:secret:b"},
+			wantAdded:   []string{"arn:aws:secretsmanager:us-east-1:1:secret:b"},
+			wantRemoved: nil,
+		},
+		{
+			name:        "remove only",
+			old:         []string{"arn:aws:secretsmanager:us-east-1:1:secret:a", "arn:aws:secretsmanager:us-east-1:1:secret:b"},
+			new:         []string{"arn:aws:secretsmanager:us-east-1:1:secret:a"},
+			wantAdded:   nil,
+			wantRemoved: []string{"arn:aws:secretsmanager:us-east-1:1:secret:b"},
+		},
+		{
+			name:        "add and remove",
+			old:         []string{"arn:aws:secretsmanager:us-east-1:1:secret:a"},
+			new:         []string{"arn:aws:secretsmanager:us-east-1:1:secret:b"},
+			wantAdded:   []string{"arn:aws:secretsmanager:us-east-1:1:secret:b"},
+			wantRemoved: []string{"arn:aws:secretsmanager:us-east-1:1:secret:a"},
+		},
+		{
+			name:        "empty to empty",
+			old:         nil,
+			new:         nil,
+			wantAdded:   nil,
+			wantRemoved: nil,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			added, removed := diffScramSecretArns(c.old, c.new)
+			sort.Strings(added)
+			sort.Strings(removed)
+
+			if !equalStringSlices(added, c.wantAdded) {
+				t.Fatalf("added = %v, want %v", added, c.wantAdded)
+			}
+			if !equalStringSlices(removed, c.wantRemoved) {
+				t.Fatalf("removed = %v, want %v", removed, c.wantRemoved)
+			}
+		})
+	}
+}
+
+func TestUnprocessedScramSecretsError(t *testing.T) {
+	if err := unprocessedScramSecretsError(nil); err != nil {
+		t.Fatalf("unprocessedScramSecretsError(nil) = %v, want nil", err)
+	}
+
+	unprocessed := []kafkatypes.UnprocessedScramSecret{
+		{
+			SecretArn:    aws.String("arn:aws:secretsmanager:us-east-1:1:secret:a"),
+			ErrorCode:    aws.String("KMSAccessDeniedException"),
+			ErrorMessage: aws.String("no resource policy grants MSK access"),
+		},
+	}
+
+	err := unprocessedScramSecretsError(unprocessed)
+	if err == nil {
+		t.Fatal("unprocessedScramSecretsError(...) = nil, want an error")
+	}
+	if !strings.Contains(err.Error(), "arn:aws:secretsmanager:us-east-1:1:secret:a") ||
+		!strings.Contains(err.Error(), "no resource policy grants MSK access") {
+		t.Fatalf("unprocessedScramSecretsError(...) = %q, missing ARN or message", err.Error())
+	}
+}
+
+func equalStringSlices(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -2,9 +2,11 @@ package resources
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"strings"
 
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/kafkaadmin"
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
 	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
 
@@ -13,6 +15,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+// saslMechanisms is the allow-list validated on sasl_mechanisms. AWS-IAM /
+// AWS_MSK_IAM and OAUTHBEARER are authenticated via the aws / oauth_bearer
+// blocks rather than sasl_username / sasl_password.
+var saslMechanisms = []string{"PLAIN", "SCRAM-SHA-256", "SCRAM-SHA-512", "AWS-IAM", "AWS_MSK_IAM", "OAUTHBEARER"}
+
 var connectionKafkaSchema = map[string]*schema.Schema{
 	"name":               ObjectNameSchema("connection", true, false),
 	"schema_name":        SchemaNameSchema("connection", false),
@@ -22,8 +29,8 @@ var connectionKafkaSchema = map[string]*schema.Schema{
 	"kafka_broker": {
 		Description:   "The Kafka broker's configuration.",
 		Type:          schema.TypeList,
-		ConflictsWith: []string{"aws_privatelink"},
-		AtLeastOneOf:  []string{"kafka_broker", "aws_privatelink"},
+		ConflictsWith: []string{"aws_privatelink", "broker_discovery"},
+		AtLeastOneOf:  []string{"kafka_broker", "aws_privatelink", "broker_discovery"},
 		Optional:      true,
 		MinItems:      1,
 		ForceNew:      true,
@@ -63,8 +70,8 @@ var connectionKafkaSchema = map[string]*schema.Schema{
 		Description:   "AWS PrivateLink configuration. Conflicts with `kafka_broker`.",
 		Type:          schema.TypeList,
 		Optional:      true,
-		ConflictsWith: []string{"kafka_broker"},
-		AtLeastOneOf:  []string{"kafka_broker", "aws_privatelink"},
+		ConflictsWith: []string{"kafka_broker", "broker_discovery"},
+		AtLeastOneOf:  []string{"kafka_broker", "aws_privatelink", "broker_discovery"},
 		MinItems:      1,
 		MaxItems:      1,
 		ForceNew:      true,
@@ -85,6 +92,87 @@ var connectionKafkaSchema = map[string]*schema.Schema{
 			},
 		},
 	},
+	"broker_discovery": {
+		Description:   "Resolves the Kafka broker list dynamically instead of requiring a manually maintained `kafka_broker` list. Exactly one of `msk`, `dns_srv`, or `confluent_cloud` must be set. Conflicts with `kafka_broker` and `aws_privatelink`.",
+		Type:          schema.TypeList,
+		Optional:      true,
+		ConflictsWith: []string{"kafka_broker", "aws_privatelink"},
+		AtLeastOneOf:  []string{"kafka_broker", "aws_privatelink", "broker_discovery"},
+		MaxItems:      1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"msk": {
+					Description:  "Discover brokers from an MSK cluster's bootstrap broker string.",
+					Type:         schema.TypeList,
+					Optional:     true,
+					MaxItems:     1,
+					ExactlyOneOf: []string{"broker_discovery.0.msk", "broker_discovery.0.dns_srv", "broker_discovery.0.confluent_cloud"},
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"cluster_arn": {
+								Description: "The ARN of the MSK cluster.",
+								Type:        schema.TypeString,
+								Required:    true,
+								ForceNew:    true,
+							},
+							"authentication_type": {
+								Description:  "Which bootstrap broker string to use: `sasl_scram`, `sasl_iam`, or `tls`.",
+								Type:         schema.TypeString,
+								Required:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.StringInSlice([]string{"sasl_scram", "sasl_iam", "tls"}, false),
+							},
+						},
+					},
+				},
+				"dns_srv": {
+					Description: "Discover brokers by resolving a DNS SRV record.",
+					Type:        schema.TypeList,
+					Optional:    true,
+					MaxItems:    1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"service": {
+								Description: "The SRV service name to resolve, e.g. `_kafka._tcp.cluster.example.com`.",
+								Type:        schema.TypeString,
+								Required:    true,
+								ForceNew:    true,
+							},
+						},
+					},
+				},
+				"confluent_cloud": {
+					Description: "Discover brokers from a Confluent Cloud cluster.",
+					Type:        schema.TypeList,
+					Optional:    true,
+					MaxItems:    1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"cluster_id": {
+								Description: "The Confluent Cloud cluster ID, e.g. `lkc-abc123`.",
+								Type:        schema.TypeString,
+								Required:    true,
+								ForceNew:    true,
+							},
+							"api_key": {
+								Description: "The Confluent Cloud API key, in the form `key:secret`.",
+								Type:        schema.TypeString,
+								Required:    true,
+								Sensitive:   true,
+								ForceNew:    true,
+							},
+						},
+					},
+				},
+				"resolved_brokers": {
+					Description: "The brokers resolved from discovery as of the last apply. Changes here on a subsequent plan indicate the upstream cluster has scaled.",
+					Type:        schema.TypeList,
+					Computed:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	},
 	"security_protocol": {
 		Description:  "The security protocol to use: `PLAINTEXT`, `SSL`, `SASL_PLAINTEXT`, or `SASL_SSL`.",
 		Type:         schema.TypeString,
@@ -110,11 +198,10 @@ var connectionKafkaSchema = map[string]*schema.Schema{
 		ForceNew:    true,
 	}),
 	"sasl_mechanisms": {
-		Description:  "The SASL mechanism for the Kafka broker.",
+		Description:  "The SASL mechanism for the Kafka broker. Accepts `AWS-IAM` / `AWS_MSK_IAM` for MSK IAM authentication via the `aws` block, and `OAUTHBEARER` for client-credentials authentication via the `oauth_bearer` block.",
 		Type:         schema.TypeString,
 		Optional:     true,
 		ValidateFunc: validation.StringInSlice(saslMechanisms, true),
-		RequiredWith: []string{"sasl_username", "sasl_password"},
 		StateFunc: func(val any) string {
 			return strings.ToUpper(val.(string))
 		},
@@ -127,6 +214,106 @@ var connectionKafkaSchema = map[string]*schema.Schema{
 		Required:    false,
 		ForceNew:    true,
 	}),
+	"msk_scram_secret": {
+		Description:   "Resolves `sasl_username` and `sasl_password` from a Materialize secret pair registered with an MSK cluster via `materialize_aws_msk_scram_secret`. Requires `security_protocol = \"SASL_SSL\"`. Conflicts with `sasl_username` and `sasl_password`.",
+		Type:          schema.TypeList,
+		Optional:      true,
+		ConflictsWith: []string{"sasl_username", "sasl_password", "aws", "oauth_bearer"},
+		MaxItems:      1,
+		ForceNew:      true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"username_secret": IdentifierSchema(IdentifierSchemaParams{
+					Elem:        "username_secret",
+					Description: "The name of a Materialize secret holding the SCRAM username.",
+					Required:    true,
+					ForceNew:    true,
+				}),
+				"password_secret": IdentifierSchema(IdentifierSchemaParams{
+					Elem:        "password_secret",
+					Description: "The name of a Materialize secret holding the SCRAM password.",
+					Required:    true,
+					ForceNew:    true,
+				}),
+			},
+		},
+	},
+	"aws": {
+		Description:   "AWS credentials used for `AWS-IAM` SASL authentication against an MSK cluster. Conflicts with `sasl_username` and `sasl_password`.",
+		Type:          schema.TypeList,
+		Optional:      true,
+		ConflictsWith: []string{"sasl_username", "sasl_password", "msk_scram_secret", "oauth_bearer"},
+		MaxItems:      1,
+		ForceNew:      true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"region": {
+					Description: "The AWS region of the MSK cluster.",
+					Type:        schema.TypeString,
+					Required:    true,
+					ForceNew:    true,
+				},
+				"access_key_id":     ValueSecretSchema("access_key_id", "The AWS access key ID.", false, true),
+				"secret_access_key": IdentifierSchema(IdentifierSchemaParams{Elem: "secret_access_key", Description: "The AWS secret access key.", Required: false, ForceNew: true}),
+				"session_token":     IdentifierSchema(IdentifierSchemaParams{Elem: "session_token", Description: "The AWS session token.", Required: false, ForceNew: true}),
+				"role_arn": {
+					Description: "The ARN of the AWS role to assume.",
+					Type:        schema.TypeString,
+					Optional:    true,
+					ForceNew:    true,
+				},
+				"external_id": {
+					Description: "The external ID to use when assuming `role_arn`.",
+					Type:        schema.TypeString,
+					Optional:    true,
+					ForceNew:    true,
+				},
+			},
+		},
+	},
+	"oauth_bearer": {
+		Description:   "OAuth client-credentials configuration used for `OAUTHBEARER` SASL authentication. Conflicts with `sasl_username` and `sasl_password`.",
+		Type:          schema.TypeList,
+		Optional:      true,
+		ConflictsWith: []string{"sasl_username", "sasl_password", "aws", "msk_scram_secret"},
+		MaxItems:      1,
+		ForceNew:      true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"token_endpoint_url": {
+					Description: "The URL of the OAuth token endpoint used to obtain bearer tokens.",
+					Type:        schema.TypeString,
+					Required:    true,
+					ForceNew:    true,
+				},
+				"client_id": {
+					Description: "The OAuth client ID.",
+					Type:        schema.TypeString,
+					Required:    true,
+					ForceNew:    true,
+				},
+				"client_secret": IdentifierSchema(IdentifierSchemaParams{
+					Elem:        "client_secret",
+					Description: "The name of a Materialize secret holding the OAuth client secret.",
+					Required:    true,
+					ForceNew:    true,
+				}),
+				"scope": {
+					Description: "The OAuth scope to request when acquiring a bearer token.",
+					Type:        schema.TypeString,
+					Optional:    true,
+					ForceNew:    true,
+				},
+				"extensions": {
+					Description: "Additional key-value pairs passed as OAuth bearer extensions.",
+					Type:        schema.TypeMap,
+					Optional:    true,
+					ForceNew:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	},
 	"ssh_tunnel": IdentifierSchema(IdentifierSchemaParams{
 		Elem:        "ssh_tunnel",
 		Description: "The default SSH tunnel configuration for the Kafka brokers.",
@@ -147,6 +334,8 @@ func ConnectionKafka() *schema.Resource {
 		UpdateContext: connectionUpdate,
 		DeleteContext: connectionDelete,
 
+		CustomizeDiff: connectionKafkaCustomizeDiff,
+
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -155,6 +344,84 @@ func ConnectionKafka() *schema.Resource {
 	}
 }
 
+func connectionKafkaCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if err := validateKafkaSASLConfiguration(d); err != nil {
+		return err
+	}
+
+	return diffKafkaBrokerDiscovery(ctx, d)
+}
+
+// diffKafkaBrokerDiscovery re-resolves broker_discovery's brokers at plan
+// time so that upstream changes (e.g. an MSK cluster scaling out) surface as
+// drift on resolved_brokers instead of only ever being resolved once, at
+// Create.
+func diffKafkaBrokerDiscovery(ctx context.Context, d *schema.ResourceDiff) error {
+	if _, ok := d.GetOk("broker_discovery"); !ok {
+		return nil
+	}
+
+	resolved, err := resolveKafkaBrokerDiscovery(ctx, d)
+	if err != nil {
+		return err
+	}
+
+	return d.SetNew("broker_discovery.0.resolved_brokers", resolved)
+}
+
+// validateKafkaSASLConfiguration ties a declared sasl_mechanisms value to the
+// block that must be populated to authenticate with it, since ConflictsWith
+// alone can't express "one of these is required when the mechanism is X".
+func validateKafkaSASLConfiguration(d *schema.ResourceDiff) error {
+	mechanism := strings.ToUpper(d.Get("sasl_mechanisms").(string))
+
+	hasUsername := len(d.Get("sasl_username").([]interface{})) > 0
+	hasPassword := len(d.Get("sasl_password").([]interface{})) > 0
+	hasAws := len(d.Get("aws").([]interface{})) > 0
+	hasOAuthBearer := len(d.Get("oauth_bearer").([]interface{})) > 0
+	hasMskScramSecret := len(d.Get("msk_scram_secret").([]interface{})) > 0
+
+	// The blocks that authenticate a specific mechanism also imply it: setting
+	// aws/oauth_bearer/sasl_username+sasl_password/msk_scram_secret without
+	// the matching sasl_mechanisms would otherwise plan and apply cleanly
+	// while emitting SQL with no SASL MECHANISMS clause at all.
+	if hasAws && mechanism != "AWS-IAM" && mechanism != "AWS_MSK_IAM" {
+		return fmt.Errorf("the aws block requires sasl_mechanisms to be \"AWS-IAM\" or \"AWS_MSK_IAM\"")
+	}
+	if hasOAuthBearer && mechanism != "OAUTHBEARER" {
+		return fmt.Errorf("the oauth_bearer block requires sasl_mechanisms to be \"OAUTHBEARER\"")
+	}
+	if (hasMskScramSecret || (hasUsername && hasPassword)) && mechanism == "" {
+		return fmt.Errorf("sasl_username/sasl_password or msk_scram_secret requires sasl_mechanisms to be set (e.g. \"PLAIN\", \"SCRAM-SHA-256\", or \"SCRAM-SHA-512\")")
+	}
+	if hasMskScramSecret {
+		if p, _ := d.Get("security_protocol").(string); !strings.EqualFold(p, "SASL_SSL") {
+			return fmt.Errorf("msk_scram_secret requires security_protocol to be \"SASL_SSL\", got %q", p)
+		}
+	}
+
+	if mechanism == "" {
+		return nil
+	}
+
+	switch mechanism {
+	case "AWS-IAM", "AWS_MSK_IAM":
+		if !hasAws {
+			return fmt.Errorf("sasl_mechanisms = %q requires the aws block", mechanism)
+		}
+	case "OAUTHBEARER":
+		if !hasOAuthBearer {
+			return fmt.Errorf("sasl_mechanisms = %q requires the oauth_bearer block", mechanism)
+		}
+	default:
+		if !hasMskScramSecret && !(hasUsername && hasPassword) {
+			return fmt.Errorf("sasl_mechanisms = %q requires sasl_username and sasl_password, or msk_scram_secret", mechanism)
+		}
+	}
+
+	return nil
+}
+
 func connectionKafkaCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	connectionName := d.Get("name").(string)
 	schemaName := d.Get("schema_name").(string)
@@ -177,6 +444,23 @@ func connectionKafkaCreate(ctx context.Context, d *schema.ResourceData, meta int
 		b.KafkaAwsPrivateLink(privatelink)
 	}
 
+	if _, ok := d.GetOk("broker_discovery"); ok {
+		resolved, err := resolveKafkaBrokerDiscovery(ctx, d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		brokers := make([]materialize.KafkaBroker, len(resolved))
+		for i, broker := range resolved {
+			brokers[i] = materialize.KafkaBroker{Broker: broker}
+		}
+		b.KafkaBrokers(brokers)
+
+		if err := d.Set("broker_discovery.0.resolved_brokers", resolved); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	if v, ok := d.GetOk("security_protocol"); ok {
 		b.KafkaSecurityProtocol(v.(string))
 	}
@@ -214,6 +498,25 @@ func connectionKafkaCreate(ctx context.Context, d *schema.ResourceData, meta int
 		b.KafkaSASLPassword(pass)
 	}
 
+	if v, ok := d.GetOk("msk_scram_secret"); ok {
+		u := v.([]interface{})[0].(map[string]interface{})
+		username := materialize.GetIdentifierSchemaStruct(u["username_secret"])
+		password := materialize.GetIdentifierSchemaStruct(u["password_secret"])
+
+		b.KafkaSASLUsername(materialize.ValueSecretStruct{Secret: username})
+		b.KafkaSASLPassword(password)
+	}
+
+	if v, ok := d.GetOk("aws"); ok {
+		awsIam := materialize.GetKafkaAwsIamStruct(v)
+		b.KafkaSASLAwsIam(awsIam)
+	}
+
+	if v, ok := d.GetOk("oauth_bearer"); ok {
+		oauthBearer := materialize.GetKafkaOAuthBearerStruct(v)
+		b.KafkaSASLOAuthBearer(oauthBearer)
+	}
+
 	if v, ok := d.GetOk("ssh_tunnel"); ok {
 		conn := materialize.GetIdentifierSchemaStruct(v)
 		b.KafkaSSHTunnel(conn)
@@ -259,3 +562,31 @@ func connectionKafkaCreate(ctx context.Context, d *schema.ResourceData, meta int
 
 	return connectionRead(ctx, d, meta)
 }
+
+// kafkaBrokerDiscoveryGetter is implemented by both *schema.ResourceData and
+// *schema.ResourceDiff, so resolveKafkaBrokerDiscovery can be reused from
+// CustomizeDiff to detect upstream broker changes, not just from Create.
+type kafkaBrokerDiscoveryGetter interface {
+	GetOk(key string) (interface{}, bool)
+}
+
+// resolveKafkaBrokerDiscovery resolves the broker list for the configured
+// broker_discovery mode (msk, dns_srv, or confluent_cloud).
+func resolveKafkaBrokerDiscovery(ctx context.Context, d kafkaBrokerDiscoveryGetter) ([]string, error) {
+	if v, ok := d.GetOk("broker_discovery.0.msk.0"); ok {
+		u := v.(map[string]interface{})
+		return kafkaadmin.ResolveMSKBootstrapBrokers(ctx, u["cluster_arn"].(string), u["authentication_type"].(string))
+	}
+
+	if v, ok := d.GetOk("broker_discovery.0.dns_srv.0"); ok {
+		u := v.(map[string]interface{})
+		return kafkaadmin.ResolveDNSSRV(ctx, u["service"].(string))
+	}
+
+	if v, ok := d.GetOk("broker_discovery.0.confluent_cloud.0"); ok {
+		u := v.(map[string]interface{})
+		return kafkaadmin.ResolveConfluentCloudBrokers(ctx, u["cluster_id"].(string), u["api_key"].(string))
+	}
+
+	return nil, fmt.Errorf("broker_discovery requires one of msk, dns_srv, or confluent_cloud")
+}
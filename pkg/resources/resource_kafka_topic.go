@@ -0,0 +1,283 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/kafkaadmin"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/utils"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var kafkaTopicSchema = map[string]*schema.Schema{
+	"connection_name": {
+		Description: "The Kafka connection to create the topic through. The connection's sasl_mechanisms must be PLAIN, SCRAM-SHA-256, or SCRAM-SHA-512 (including via msk_scram_secret), or unset; kafkaadmin does not yet support AWS-IAM/AWS_MSK_IAM or OAUTHBEARER connections.",
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+	},
+	"schema_name":   SchemaNameSchema("connection", false),
+	"database_name": DatabaseNameSchema("connection", false),
+	"topic_name": {
+		Description: "The name of the Kafka topic.",
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+	},
+	"partitions": {
+		Description: "The number of partitions for the topic. Kafka does not support reducing a topic's partition count, and this provider does not yet support increasing it in place, so changing this value replaces the topic.",
+		Type:        schema.TypeInt,
+		Required:    true,
+		ForceNew:    true,
+	},
+	"replication_factor": {
+		Description: "The replication factor for the topic.",
+		Type:        schema.TypeInt,
+		Required:    true,
+		ForceNew:    true,
+	},
+	"config": {
+		Description: "Topic-level configuration overrides, e.g. `retention.ms`, `cleanup.policy`, or `compression.type`.",
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	},
+	"sasl_username": {
+		Description: "The username to authenticate to the Kafka cluster with. Required if the connection's `sasl_mechanisms` requires credentials; Materialize does not expose a connection's secret material back out, so it must be supplied here directly.",
+		Type:        schema.TypeString,
+		Optional:    true,
+	},
+	"sasl_password": {
+		Description: "The password to authenticate to the Kafka cluster with. Required if the connection's `sasl_mechanisms` requires credentials; Materialize does not expose a connection's secret material back out, so it must be supplied here directly.",
+		Type:        schema.TypeString,
+		Optional:    true,
+		Sensitive:   true,
+	},
+	"create_if_not_exists": {
+		Description: "Do not error if the topic already exists.",
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+	},
+	"delete_on_destroy": {
+		Description: "Delete the Kafka topic when this resource is destroyed.",
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     true,
+	},
+	"region": RegionSchema(),
+}
+
+func KafkaTopic() *schema.Resource {
+	return &schema.Resource{
+		Description: "A Kafka topic managed through an existing materialize_connection_kafka connection. " +
+			"The connection must authenticate with PLAIN, SCRAM-SHA-256, or SCRAM-SHA-512 (directly or via " +
+			"msk_scram_secret), or use no SASL mechanism at all; kafkaadmin.Client does not yet implement " +
+			"AWS IAM or OAUTHBEARER authentication, so connections using those mechanisms are rejected.",
+
+		CreateContext: kafkaTopicCreate,
+		ReadContext:   kafkaTopicRead,
+		UpdateContext: kafkaTopicUpdate,
+		DeleteContext: kafkaTopicDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: kafkaTopicImport,
+		},
+
+		Schema: kafkaTopicSchema,
+	}
+}
+
+// kafkaTopicImport splits the database.schema.connection.topic id
+// kafkaTopicCreate sets back into its four fields. Plain
+// ImportStatePassthroughContext only sets d.Id(); kafkaTopicRead reads
+// connection_name/schema_name/database_name via d.Get, so without this they'd
+// be empty strings right after import.
+func kafkaTopicImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), ".")
+	if len(parts) != 4 {
+		return nil, fmt.Errorf("id %q is not in the format database.schema.connection.topic", d.Id())
+	}
+
+	if err := d.Set("database_name", parts[0]); err != nil {
+		return nil, err
+	}
+	if err := d.Set("schema_name", parts[1]); err != nil {
+		return nil, err
+	}
+	if err := d.Set("connection_name", parts[2]); err != nil {
+		return nil, err
+	}
+	if err := d.Set("topic_name", parts[3]); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// supportedKafkaTopicSASLMechanisms are the sasl_mechanisms values
+// kafkaadmin.Client can authenticate with. AWS-IAM/AWS_MSK_IAM and
+// OAUTHBEARER connections are valid materialize_connection_kafka resources,
+// but kafkaadmin talks to the broker directly over kafka-go and doesn't yet
+// implement either mechanism, so a kafka_topic pointed at one must fail
+// clearly instead of deep inside kafkaadmin.NewClient.
+var supportedKafkaTopicSASLMechanisms = map[string]bool{
+	"":              true,
+	"PLAIN":         true,
+	"SCRAM-SHA-256": true,
+	"SCRAM-SHA-512": true,
+}
+
+// validateKafkaTopicConnectionConfig rejects a connection config whose
+// sasl_mechanism kafkaadmin.Client can't authenticate with, so that's
+// surfaced as a clear, topic-resource-level error as early as kafka_topic
+// resolves the connection, rather than as kafkaadmin's generic "unsupported
+// SASL mechanism" error once NewClient is reached.
+func validateKafkaTopicConnectionConfig(connectionName string, config materialize.KafkaConnectionConfig) error {
+	mechanism := strings.ToUpper(config.SASLMechanism)
+	if !supportedKafkaTopicSASLMechanisms[mechanism] {
+		return fmt.Errorf(
+			"materialize_kafka_topic does not support connection %q's sasl_mechanisms %q; only PLAIN, SCRAM-SHA-256, SCRAM-SHA-512 (including via msk_scram_secret), or no SASL mechanism are supported",
+			connectionName, config.SASLMechanism,
+		)
+	}
+	return nil
+}
+
+func kafkaAdminClient(ctx context.Context, d *schema.ResourceData, meta interface{}) (*kafkaadmin.Client, error) {
+	metaDb, _, err := utils.GetDBClientFromMeta(meta, d)
+	if err != nil {
+		return nil, err
+	}
+
+	connectionName := d.Get("connection_name").(string)
+	config, err := materialize.ReadKafkaConnectionConfig(
+		metaDb,
+		connectionName,
+		d.Get("schema_name").(string),
+		d.Get("database_name").(string),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateKafkaTopicConnectionConfig(connectionName, config); err != nil {
+		return nil, err
+	}
+
+	return kafkaadmin.NewClient(kafkaadmin.Config{
+		Brokers:       config.Brokers,
+		TLS:           config.TLS,
+		SASLMechanism: strings.ToUpper(config.SASLMechanism),
+		SASLUsername:  d.Get("sasl_username").(string),
+		SASLPassword:  d.Get("sasl_password").(string),
+	})
+}
+
+func kafkaTopicConfig(d *schema.ResourceData) map[string]string {
+	return stringMapFromConfig(d.Get("config").(map[string]interface{}))
+}
+
+func stringMapFromConfig(raw map[string]interface{}) map[string]string {
+	config := make(map[string]string, len(raw))
+	for k, v := range raw {
+		config[k] = v.(string)
+	}
+	return config
+}
+
+// filterDeclaredTopicConfig restricts actual (everything DescribeTopic
+// returned) to the keys present in declared (what the user set in config),
+// so kafkaTopicRead doesn't write back broker defaults the user never asked
+// this resource to manage.
+func filterDeclaredTopicConfig(declared map[string]interface{}, actual map[string]string) map[string]interface{} {
+	config := make(map[string]interface{}, len(declared))
+	for k := range declared {
+		if v, ok := actual[k]; ok {
+			config[k] = v
+		}
+	}
+	return config
+}
+
+func kafkaTopicCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := kafkaAdminClient(ctx, d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	topicName := d.Get("topic_name").(string)
+
+	topic := kafkaadmin.TopicConfig{
+		Name:              topicName,
+		Partitions:        d.Get("partitions").(int),
+		ReplicationFactor: d.Get("replication_factor").(int),
+		Config:            kafkaTopicConfig(d),
+	}
+
+	if err := client.CreateTopic(ctx, topic, d.Get("create_if_not_exists").(bool)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s.%s.%s.%s", d.Get("database_name").(string), d.Get("schema_name").(string), d.Get("connection_name").(string), topicName))
+
+	return kafkaTopicRead(ctx, d, meta)
+}
+
+func kafkaTopicRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := kafkaAdminClient(ctx, d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	info, err := client.DescribeTopic(ctx, d.Get("topic_name").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("partitions", info.Partitions); err != nil {
+		return diag.FromErr(err)
+	}
+
+	config := filterDeclaredTopicConfig(d.Get("config").(map[string]interface{}), info.Config)
+	if err := d.Set("config", config); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func kafkaTopicUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, err := kafkaAdminClient(ctx, d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("config") {
+		if err := client.AlterTopicConfig(ctx, d.Get("topic_name").(string), kafkaTopicConfig(d)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return kafkaTopicRead(ctx, d, meta)
+}
+
+func kafkaTopicDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if !d.Get("delete_on_destroy").(bool) {
+		return nil
+	}
+
+	client, err := kafkaAdminClient(ctx, d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := client.DeleteTopic(ctx, d.Get("topic_name").(string)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
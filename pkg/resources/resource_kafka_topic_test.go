@@ -0,0 +1,109 @@
+package resources
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/MaterializeInc/terraform-provider-materialize/pkg/materialize"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestStringMapFromConfig(t *testing.T) {
+	raw := map[string]interface{}{"retention.ms": "604800000", "cleanup.policy": "delete"}
+	want := map[string]string{"retention.ms": "604800000", "cleanup.policy": "delete"}
+
+	got := stringMapFromConfig(raw)
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("stringMapFromConfig(%v) = %v, want %v", raw, got, want)
+	}
+}
+
+func TestFilterDeclaredTopicConfig(t *testing.T) {
+	declared := map[string]interface{}{"retention.ms": "604800000", "cleanup.policy": "delete"}
+	actual := map[string]string{
+		"retention.ms":        "604800000",
+		"cleanup.policy":      "delete",
+		"segment.bytes":       "1073741824",
+		"min.insync.replicas": "1",
+	}
+	want := map[string]interface{}{"retention.ms": "604800000", "cleanup.policy": "delete"}
+
+	got := filterDeclaredTopicConfig(declared, actual)
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("filterDeclaredTopicConfig(...) = %v, want %v", got, want)
+	}
+}
+
+func TestFilterDeclaredTopicConfig_MissingKeyOmitted(t *testing.T) {
+	declared := map[string]interface{}{"retention.ms": "604800000"}
+	actual := map[string]string{"cleanup.policy": "delete"}
+
+	got := filterDeclaredTopicConfig(declared, actual)
+	if len(got) != 0 {
+		t.Fatalf("filterDeclaredTopicConfig(...) = %v, want empty", got)
+	}
+}
+
+func TestKafkaTopicImport(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, kafkaTopicSchema, map[string]interface{}{})
+	d.SetId("my_db.my_schema.my_connection.my_topic")
+
+	got, err := kafkaTopicImport(context.Background(), d, nil)
+	if err != nil {
+		t.Fatalf("kafkaTopicImport(...) returned error: %v", err)
+	}
+	if len(got) != 1 {
+		t.Fatalf("kafkaTopicImport(...) returned %d ResourceData, want 1", len(got))
+	}
+
+	want := map[string]string{
+		"database_name":   "my_db",
+		"schema_name":     "my_schema",
+		"connection_name": "my_connection",
+		"topic_name":      "my_topic",
+	}
+	for field, value := range want {
+		if got := got[0].Get(field).(string); got != value {
+			t.Errorf("%s = %q, want %q", field, got, value)
+		}
+	}
+}
+
+func TestValidateKafkaTopicConnectionConfig(t *testing.T) {
+	cases := []struct {
+		name      string
+		mechanism string
+		wantErr   bool
+	}{
+		{name: "no SASL", mechanism: "", wantErr: false},
+		{name: "plain", mechanism: "PLAIN", wantErr: false},
+		{name: "scram 256", mechanism: "SCRAM-SHA-256", wantErr: false},
+		{name: "scram 512 lowercase", mechanism: "scram-sha-512", wantErr: false},
+		{name: "aws iam", mechanism: "AWS-IAM", wantErr: true},
+		{name: "aws msk iam", mechanism: "AWS_MSK_IAM", wantErr: true},
+		{name: "oauthbearer", mechanism: "OAUTHBEARER", wantErr: true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := validateKafkaTopicConnectionConfig("my_connection", materialize.KafkaConnectionConfig{SASLMechanism: c.mechanism})
+			if c.wantErr && err == nil {
+				t.Fatalf("validateKafkaTopicConnectionConfig(%q) = nil, want an error", c.mechanism)
+			}
+			if !c.wantErr && err != nil {
+				t.Fatalf("validateKafkaTopicConnectionConfig(%q) = %v, want nil", c.mechanism, err)
+			}
+		})
+	}
+}
+
+func TestKafkaTopicImport_InvalidID(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, kafkaTopicSchema, map[string]interface{}{})
+	d.SetId("not-enough-parts")
+
+	if _, err := kafkaTopicImport(context.Background(), d, nil); err == nil {
+		t.Fatal("kafkaTopicImport(...) returned nil error, want an error for a malformed id")
+	}
+}